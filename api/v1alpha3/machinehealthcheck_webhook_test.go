@@ -0,0 +1,69 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestMachineHealthCheck_ValidateCreate(t *testing.T) {
+	testCases := []struct {
+		name         string
+		maxUnhealthy *intstr.IntOrString
+		expectErr    bool
+	}{
+		{
+			name:         "MaxUnhealthy unset",
+			maxUnhealthy: nil,
+		},
+		{
+			name:         "MaxUnhealthy an absolute number",
+			maxUnhealthy: intStrPtr(intstr.FromInt(2)),
+		},
+		{
+			name:         "MaxUnhealthy a valid percentage",
+			maxUnhealthy: intStrPtr(intstr.FromString("40%")),
+		},
+		{
+			name:         "MaxUnhealthy a malformed percentage",
+			maxUnhealthy: intStrPtr(intstr.FromString("not-a-percent")),
+			expectErr:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			m := &MachineHealthCheck{Spec: MachineHealthCheckSpec{MaxUnhealthy: tc.maxUnhealthy}}
+
+			err := m.ValidateCreate()
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
+
+func intStrPtr(v intstr.IntOrString) *intstr.IntOrString {
+	return &v
+}
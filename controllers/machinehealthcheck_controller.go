@@ -18,11 +18,15 @@ package controllers
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/credentials"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -30,17 +34,22 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/controllers/mhc/remediation"
 	"sigs.k8s.io/cluster-api/controllers/remote"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
@@ -49,20 +58,62 @@ import (
 const (
 	mhcClusterNameIndex  = "spec.clusterName"
 	machineNodeNameIndex = "status.nodeRef.name"
+
+	// machineRemediationTimestampAnnotation records when the MachineHealthCheck
+	// controller triggered remediation for a Machine, so operators can audit
+	// when and why a Machine was torn down.
+	machineRemediationTimestampAnnotation = "cluster.x-k8s.io/remediation-timestamp"
+	// machineRemediationReasonAnnotation records why the MachineHealthCheck
+	// controller triggered remediation for a Machine.
+	machineRemediationReasonAnnotation = "cluster.x-k8s.io/remediation-reason"
+
+	// mhcFinalizer lets the reconciler release its reference on a target
+	// Cluster's Node informer before a MachineHealthCheck is garbage
+	// collected.
+	mhcFinalizer = "machinehealthcheck.cluster.x-k8s.io"
 )
 
+// clusterNodeInformerEntry tracks a single target cluster's Node informer
+// along with the MachineHealthChecks currently relying on it, so the
+// informer is only stopped once the last one stops watching that Cluster.
+type clusterNodeInformerEntry struct {
+	informer cache.Informer
+	cancel   context.CancelFunc
+	mhcs     map[types.NamespacedName]struct{}
+}
+
 // MachineHealthCheckReconciler reconciles a MachineHealthCheck object
 type MachineHealthCheckReconciler struct {
 	Client client.Client
 	Log    logr.Logger
 
-	controller           controller.Controller
-	recorder             record.EventRecorder
-	scheme               *runtime.Scheme
-	clusterNodeInformers map[types.NamespacedName]cache.Informer
+	controller controller.Controller
+	recorder   record.EventRecorder
+	scheme     *runtime.Scheme
+
+	clusterNodeInformersLock sync.Mutex
+	clusterNodeInformers     map[types.NamespacedName]*clusterNodeInformerEntry
+
+	remediationStrategiesLock sync.Mutex
+	remediationStrategies     map[types.NamespacedName]remediation.RemediationStrategy
+}
+
+// MachineHealthCheckReconcilerOptions groups the controller.Options used to
+// build the underlying controller with the periods for the periodic safety
+// sweeps, which run independently of any watch. A zero period disables the
+// corresponding sweep.
+type MachineHealthCheckReconcilerOptions struct {
+	controller.Options
+
+	// SafetyOrphanNodePeriod is how often to sweep target clusters for
+	// Nodes with no corresponding Machine.
+	SafetyOrphanNodePeriod time.Duration
+	// SafetyOvershootingPeriod is how often to sweep MachineHealthChecks
+	// whose selector matches more Machines than ExpectedMachines allows.
+	SafetyOvershootingPeriod time.Duration
 }
 
-func (r *MachineHealthCheckReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+func (r *MachineHealthCheckReconciler) SetupWithManager(mgr ctrl.Manager, options MachineHealthCheckReconcilerOptions) error {
 	controller, err := ctrl.NewControllerManagedBy(mgr).
 		For(&clusterv1.MachineHealthCheck{}).
 		Watches(
@@ -73,13 +124,32 @@ func (r *MachineHealthCheckReconciler) SetupWithManager(mgr ctrl.Manager, option
 			&source.Kind{Type: &clusterv1.Machine{}},
 			&handler.EnqueueRequestsFromMapFunc{ToRequests: handler.ToRequestsFunc(r.machineToMachineHealthCheck)},
 		).
-		WithOptions(options).
+		WithOptions(options.Options).
 		Build(r)
 
 	if err != nil {
 		return errors.Wrap(err, "failed setting up with a controller manager")
 	}
 
+	// Stop and remove the target cluster's Node informer as soon as the
+	// Cluster is deleted, regardless of how many MachineHealthChecks still
+	// reference it.
+	if err := controller.Watch(
+		&source.Kind{Type: &clusterv1.Cluster{}},
+		&handler.Funcs{
+			DeleteFunc: func(e event.DeleteEvent, q workqueue.RateLimitingInterface) {
+				c, ok := e.Object.(*clusterv1.Cluster)
+				if !ok {
+					return
+				}
+				r.removeClusterNodeInformer(types.NamespacedName{Namespace: c.Namespace, Name: c.Name})
+				r.closeRemediationStrategiesForCluster(context.Background(), c)
+			},
+		},
+	); err != nil {
+		return errors.Wrap(err, "failed to watch for Cluster deletions")
+	}
+
 	// Add index to MachineHealthCheck for listing by Cluster Name
 	if err := mgr.GetCache().IndexField(&clusterv1.MachineHealthCheck{},
 		mhcClusterNameIndex,
@@ -99,7 +169,13 @@ func (r *MachineHealthCheckReconciler) SetupWithManager(mgr ctrl.Manager, option
 	r.controller = controller
 	r.recorder = mgr.GetEventRecorderFor("machinehealthcheck-controller")
 	r.scheme = mgr.GetScheme()
-	r.clusterNodeInformers = make(map[types.NamespacedName]cache.Informer)
+	r.clusterNodeInformers = make(map[types.NamespacedName]*clusterNodeInformerEntry)
+	r.remediationStrategies = make(map[types.NamespacedName]remediation.RemediationStrategy)
+
+	if err := r.setupSafetySweeps(mgr, options); err != nil {
+		return errors.Wrap(err, "failed to set up periodic safety sweeps")
+	}
+
 	return nil
 }
 
@@ -121,6 +197,32 @@ func (r *MachineHealthCheckReconciler) Reconcile(req ctrl.Request) (_ ctrl.Resul
 		return ctrl.Result{}, err
 	}
 
+	clusterKey := types.NamespacedName{Namespace: m.Namespace, Name: m.Spec.ClusterName}
+
+	// The mhcFinalizer lets us release this MachineHealthCheck's reference on
+	// its target cluster's Node informer, and close its cached remediation
+	// strategy's connection, before the object is garbage collected, so
+	// long-lived resources are stopped once the last MHC using them goes
+	// away.
+	if !m.ObjectMeta.DeletionTimestamp.IsZero() {
+		if util.Contains(m.Finalizers, mhcFinalizer) {
+			r.releaseClusterNodeInformer(clusterKey, req.NamespacedName)
+			r.closeRemediationStrategy(req.NamespacedName)
+			m.Finalizers = util.Filter(m.Finalizers, mhcFinalizer)
+			if err := r.Client.Update(ctx, m); err != nil {
+				return ctrl.Result{}, errors.Wrap(err, "failed to remove finalizer from MachineHealthCheck")
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if !util.Contains(m.Finalizers, mhcFinalizer) {
+		m.Finalizers = append(m.Finalizers, mhcFinalizer)
+		if err := r.Client.Update(ctx, m); err != nil {
+			return ctrl.Result{}, errors.Wrap(err, "failed to add finalizer to MachineHealthCheck")
+		}
+	}
+
 	cluster, err := util.GetClusterByName(ctx, r.Client, m.Namespace, m.Spec.ClusterName)
 	if err != nil {
 		logger.Error(err, "Failed to fetch Cluster for MachineHealthCheck")
@@ -185,7 +287,8 @@ func (r *MachineHealthCheckReconciler) reconcile(ctx context.Context, cluster *c
 		return ctrl.Result{}, err
 	}
 
-	err = r.watchClusterNodes(ctx, r.Client, cluster)
+	mhcKey := types.NamespacedName{Namespace: m.Namespace, Name: m.Name}
+	err = r.watchClusterNodes(ctx, r.Client, cluster, mhcKey)
 	if err != nil {
 		logger.Error(err, "Error watching nodes on target cluster")
 		return ctrl.Result{}, err
@@ -211,10 +314,42 @@ func (r *MachineHealthCheckReconciler) reconcile(ctx context.Context, cluster *c
 	currentHealthy, needRemediationTargets, nextCheckTimes := r.healthCheckTargets(targets, logger, timeoutForMachineToHaveNode)
 	m.Status.CurrentHealthy = int32(currentHealthy)
 
+	// Check that remediating every unhealthy target in this pass would not
+	// exceed MaxUnhealthy before remediating any of them, so a cluster-wide
+	// outage (e.g. a network partition that takes every Node NotReady) can't
+	// cause a mass-deletion of Machines.
+	if !isAllowedRemediation(m) {
+		logger.V(3).Info("Short-circuiting remediation because the number of unhealthy machines exceeds MaxUnhealthy",
+			"total", totalTargets, "currentHealthy", currentHealthy, "maxUnhealthy", m.Spec.MaxUnhealthy)
+
+		message := fmt.Sprintf("Remediation is not allowed, the number of not started or unhealthy machines exceeds maxUnhealthy (total: %v, unhealthy: %v, maxUnhealthy: %v)",
+			totalTargets, totalTargets-currentHealthy, m.Spec.MaxUnhealthy)
+		conditions.MarkFalse(m, clusterv1.RemediationAllowedCondition, clusterv1.TooManyUnhealthyReason, clusterv1.ConditionSeverityWarning, "%s", message)
+		r.recorder.Event(m, corev1.EventTypeWarning, "RemediationRestricted", message)
+
+		if minNextCheck := minDuration(nextCheckTimes); minNextCheck > 0 {
+			return ctrl.Result{RequeueAfter: minNextCheck}, nil
+		}
+		return ctrl.Result{}, nil
+	}
+	conditions.MarkTrue(m, clusterv1.RemediationAllowedCondition)
+
 	// remediate
+	var errs []error
 	for _, t := range needRemediationTargets {
 		logger.V(3).Info("Target meets unhealthy criteria, triggers remediation", "target", t.string())
-		// TODO(JoelSpeed): Implement remediation logic
+		requeueAfter, err := r.remediate(ctx, logger, t)
+		if err != nil {
+			logger.Error(err, "Failed to remediate target", "target", t.string())
+			errs = append(errs, err)
+			continue
+		}
+		if requeueAfter > 0 {
+			nextCheckTimes = append(nextCheckTimes, requeueAfter)
+		}
+	}
+	if len(errs) > 0 {
+		return ctrl.Result{}, kerrors.NewAggregate(errs)
 	}
 
 	if minNextCheck := minDuration(nextCheckTimes); minNextCheck > 0 {
@@ -349,10 +484,24 @@ func (r *MachineHealthCheckReconciler) getMachineFromNode(nodeName string) (*clu
 	return &machineList.Items[0], nil
 }
 
-func (r *MachineHealthCheckReconciler) watchClusterNodes(ctx context.Context, c client.Client, cluster *clusterv1.Cluster) error {
-	key := types.NamespacedName{Namespace: cluster.Name, Name: cluster.Name}
-	if _, ok := r.clusterNodeInformers[key]; ok {
-		// watch was already set up for this cluster
+// watchClusterNodes ensures a Node informer is running for cluster's target
+// cluster, starting one on first use and registering mhcKey as a referencing
+// MachineHealthCheck. Callers are expected to later release that reference
+// via releaseClusterNodeInformer once the MachineHealthCheck stops watching
+// this Cluster.
+func (r *MachineHealthCheckReconciler) watchClusterNodes(ctx context.Context, c client.Client, cluster *clusterv1.Cluster, mhcKey types.NamespacedName) error {
+	key := types.NamespacedName{Namespace: cluster.Namespace, Name: cluster.Name}
+
+	r.clusterNodeInformersLock.Lock()
+	defer r.clusterNodeInformersLock.Unlock()
+
+	if r.clusterNodeInformers == nil {
+		r.clusterNodeInformers = make(map[types.NamespacedName]*clusterNodeInformerEntry)
+	}
+
+	if entry, ok := r.clusterNodeInformers[key]; ok {
+		// watch was already set up for this cluster, just track the new referrer
+		entry.mhcs[mhcKey] = struct{}{}
 		return nil
 	}
 
@@ -366,27 +515,65 @@ func (r *MachineHealthCheckReconciler) watchClusterNodes(ctx context.Context, c
 		return errors.Wrap(err, "error constructing remote cluster client")
 	}
 
+	informerCtx, cancel := context.WithCancel(context.Background())
+
 	// TODO(JoelSpeed): See if we use the resync period from the manager instead of 0
 	factory := informers.NewSharedInformerFactory(k8sClient, 0)
 	nodeInformer := factory.Core().V1().Nodes().Informer()
-	go nodeInformer.Run(ctx.Done())
+	go nodeInformer.Run(informerCtx.Done())
 
 	err = r.controller.Watch(
 		&source.Informer{Informer: nodeInformer},
 		&handler.EnqueueRequestsFromMapFunc{ToRequests: handler.ToRequestsFunc(r.nodeToMachineHealthCheck)},
 	)
 	if err != nil {
+		cancel()
 		return errors.Wrap(err, "error watching nodes on target cluster")
 	}
 
-	if r.clusterNodeInformers == nil {
-		r.clusterNodeInformers = make(map[types.NamespacedName]cache.Informer)
+	r.clusterNodeInformers[key] = &clusterNodeInformerEntry{
+		informer: nodeInformer,
+		cancel:   cancel,
+		mhcs:     map[types.NamespacedName]struct{}{mhcKey: {}},
 	}
-
-	r.clusterNodeInformers[key] = nodeInformer
 	return nil
 }
 
+// releaseClusterNodeInformer removes mhcKey's reference on clusterKey's Node
+// informer, stopping and removing the informer once no MachineHealthCheck
+// references it any longer.
+func (r *MachineHealthCheckReconciler) releaseClusterNodeInformer(clusterKey, mhcKey types.NamespacedName) {
+	r.clusterNodeInformersLock.Lock()
+	defer r.clusterNodeInformersLock.Unlock()
+
+	entry, ok := r.clusterNodeInformers[clusterKey]
+	if !ok {
+		return
+	}
+
+	delete(entry.mhcs, mhcKey)
+	if len(entry.mhcs) == 0 {
+		entry.cancel()
+		delete(r.clusterNodeInformers, clusterKey)
+	}
+}
+
+// removeClusterNodeInformer unconditionally stops and removes clusterKey's
+// Node informer, regardless of how many MachineHealthChecks still reference
+// it. Used when the Cluster itself is deleted.
+func (r *MachineHealthCheckReconciler) removeClusterNodeInformer(clusterKey types.NamespacedName) {
+	r.clusterNodeInformersLock.Lock()
+	defer r.clusterNodeInformersLock.Unlock()
+
+	entry, ok := r.clusterNodeInformers[clusterKey]
+	if !ok {
+		return
+	}
+
+	entry.cancel()
+	delete(r.clusterNodeInformers, clusterKey)
+}
+
 func (r *MachineHealthCheckReconciler) indexMachineByNodeName(object runtime.Object) []string {
 	machine, ok := object.(*clusterv1.Machine)
 	if !ok {
@@ -401,6 +588,204 @@ func (r *MachineHealthCheckReconciler) indexMachineByNodeName(object runtime.Obj
 	return nil
 }
 
+// remediate annotates the Machine behind an unhealthy target for audit
+// purposes, then hands it off to the MachineHealthCheck's configured
+// RemediationStrategy. It returns the requeueAfter the strategy asked for, if
+// any.
+func (r *MachineHealthCheckReconciler) remediate(ctx context.Context, logger logr.Logger, t target) (time.Duration, error) {
+	machineToRemediate := t.Machine
+
+	if !machineToRemediate.DeletionTimestamp.IsZero() {
+		logger.V(3).Info("Machine already being deleted, skipping remediation", "target", t.string())
+		return 0, nil
+	}
+
+	patchHelper, err := patch.NewHelper(machineToRemediate, r.Client)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to build patch helper for Machine %s/%s", machineToRemediate.Namespace, machineToRemediate.Name)
+	}
+
+	reason := fmt.Sprintf("MachineHealthCheck %s/%s marked this Machine unhealthy", t.MHC.Namespace, t.MHC.Name)
+	if machineToRemediate.Annotations == nil {
+		machineToRemediate.Annotations = make(map[string]string)
+	}
+	machineToRemediate.Annotations[machineRemediationTimestampAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	machineToRemediate.Annotations[machineRemediationReasonAnnotation] = reason
+
+	if err := patchHelper.Patch(ctx, machineToRemediate); err != nil {
+		return 0, errors.Wrapf(err, "failed to annotate Machine %s/%s for remediation", machineToRemediate.Namespace, machineToRemediate.Name)
+	}
+
+	strategy, err := r.remediationStrategyFor(ctx, t.MHC)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to resolve remediation strategy")
+	}
+
+	logger.V(3).Info("Remediating unhealthy Machine", "target", t.string())
+	r.recorder.Eventf(machineToRemediate, corev1.EventTypeNormal, "MachineRemediationTriggered", "Machine %s/%s has failed health checks and is being remediated", machineToRemediate.Namespace, machineToRemediate.Name)
+
+	requeueAfter, err := strategy.Remediate(ctx, remediation.Target{
+		Cluster: t.Cluster,
+		MHC:     t.MHC,
+		Machine: machineToRemediate,
+		Node:    t.Node,
+		Reason:  reason,
+	})
+	if err != nil {
+		return 0, errors.Wrapf(err, "remediation strategy failed for Machine %s/%s", machineToRemediate.Namespace, machineToRemediate.Name)
+	}
+
+	return requeueAfter, nil
+}
+
+// remediationStrategyFor returns the RemediationStrategy configured for m.
+// MachineHealthChecks without a RemediationTemplate fall back to deleting the
+// Machine directly; those referencing one delegate to a gRPC remediation
+// provider instead. RemediationTemplate is an ObjectReference to a Secret
+// carrying the provider's endpoint in its "url" key and, optionally, a
+// "tls.crt"/"tls.key" (and "ca.crt") pair to authenticate over TLS.
+//
+// The gRPC strategy is built once per MachineHealthCheck and cached, since it
+// owns a long-lived connection to the remediation provider; repeated targets
+// on the same MHC reuse it instead of dialing again. The cache entry is
+// closed and dropped once the MHC's finalizer is released (see Reconcile and
+// closeRemediationStrategy) or its Cluster is deleted (see
+// closeRemediationStrategiesForCluster).
+func (r *MachineHealthCheckReconciler) remediationStrategyFor(ctx context.Context, m *clusterv1.MachineHealthCheck) (remediation.RemediationStrategy, error) {
+	if m.Spec.RemediationTemplate == nil {
+		return remediation.MachineDeletion{Client: r.Client}, nil
+	}
+
+	mhcKey := namespacedName(m)
+
+	r.remediationStrategiesLock.Lock()
+	defer r.remediationStrategiesLock.Unlock()
+
+	if r.remediationStrategies == nil {
+		r.remediationStrategies = make(map[types.NamespacedName]remediation.RemediationStrategy)
+	}
+	if strategy, ok := r.remediationStrategies[mhcKey]; ok {
+		return strategy, nil
+	}
+
+	ref := m.Spec.RemediationTemplate
+	refNamespace := ref.Namespace
+	if refNamespace == "" {
+		refNamespace = m.Namespace
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: refNamespace, Name: ref.Name}, secret); err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch remediation provider secret %q", ref.Name)
+	}
+
+	endpoint, ok := secret.Data["url"]
+	if !ok {
+		return nil, errors.Errorf("remediation provider secret %q has no %q key", ref.Name, "url")
+	}
+
+	var creds credentials.TransportCredentials
+	if _, ok := secret.Data["tls.crt"]; ok {
+		tlsCreds, err := credentialsFromSecret(secret)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to build TLS credentials from secret %q", ref.Name)
+		}
+		creds = tlsCreds
+	}
+
+	strategy := &remediation.GRPCRemediation{Endpoint: string(endpoint), TLSConfig: creds}
+	r.remediationStrategies[mhcKey] = strategy
+	return strategy, nil
+}
+
+// closeRemediationStrategy closes and drops mhcKey's cached remediation
+// strategy, if any, releasing its connection to the remediation provider.
+func (r *MachineHealthCheckReconciler) closeRemediationStrategy(mhcKey types.NamespacedName) {
+	r.remediationStrategiesLock.Lock()
+	defer r.remediationStrategiesLock.Unlock()
+
+	strategy, ok := r.remediationStrategies[mhcKey]
+	if !ok {
+		return
+	}
+	if closer, ok := strategy.(interface{ Close() error }); ok {
+		if err := closer.Close(); err != nil {
+			r.Log.Error(err, "Failed to close cached remediation strategy", "machinehealthcheck", mhcKey.Name, "namespace", mhcKey.Namespace)
+		}
+	}
+	delete(r.remediationStrategies, mhcKey)
+}
+
+// closeRemediationStrategiesForCluster closes the cached remediation
+// strategies for every MachineHealthCheck targeting cluster, since their
+// finalizer-driven cleanup races the Cluster's own deletion and may never
+// run once the Cluster (and its owned MHCs) are gone.
+func (r *MachineHealthCheckReconciler) closeRemediationStrategiesForCluster(ctx context.Context, cluster *clusterv1.Cluster) {
+	mhcList := &clusterv1.MachineHealthCheckList{}
+	if err := r.Client.List(
+		ctx,
+		mhcList,
+		client.InNamespace(cluster.Namespace),
+		client.MatchingFields{mhcClusterNameIndex: cluster.Name},
+	); err != nil {
+		r.Log.Error(err, "Unable to list MachineHealthChecks to close remediation strategies", "cluster", cluster.Name, "namespace", cluster.Namespace)
+		return
+	}
+
+	for i := range mhcList.Items {
+		r.closeRemediationStrategy(namespacedName(&mhcList.Items[i]))
+	}
+}
+
+// credentialsFromSecret builds gRPC transport credentials from a Secret
+// carrying a "tls.crt"/"tls.key" pair and, optionally, a "ca.crt" used to
+// verify the remediation provider's certificate.
+func credentialsFromSecret(secret *corev1.Secret) (credentials.TransportCredentials, error) {
+	cert, err := tls.X509KeyPair(secret.Data["tls.crt"], secret.Data["tls.key"])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse tls.crt/tls.key")
+	}
+
+	tlsConfig := &tls.Config{Certificates: []tls.Certificate{cert}}
+	if ca, ok := secret.Data["ca.crt"]; ok {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, errors.New("failed to parse ca.crt")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// isAllowedRemediation returns false if remediating all the currently
+// unhealthy targets would exceed the MachineHealthCheck's MaxUnhealthy
+// threshold.
+func isAllowedRemediation(m *clusterv1.MachineHealthCheck) bool {
+	maxUnhealthy, err := getMaxUnhealthy(m)
+	if err != nil {
+		return false
+	}
+
+	unhealthy := int(m.Status.ExpectedMachines) - int(m.Status.CurrentHealthy)
+	return unhealthy <= maxUnhealthy
+}
+
+// getMaxUnhealthy resolves Spec.MaxUnhealthy (an absolute number or a
+// percentage of ExpectedMachines) to an absolute count. A nil MaxUnhealthy
+// is treated as "no limit".
+func getMaxUnhealthy(m *clusterv1.MachineHealthCheck) (int, error) {
+	if m.Spec.MaxUnhealthy == nil {
+		return int(m.Status.ExpectedMachines), nil
+	}
+
+	maxUnhealthy, err := intstr.GetScaledValueFromIntOrPercent(m.Spec.MaxUnhealthy, int(m.Status.ExpectedMachines), false)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to calculate maxUnhealthy from MachineHealthCheck")
+	}
+	return maxUnhealthy, nil
+}
+
 // hasMatchingLabels verifies that the MachineHealthCheck's label selector
 // matches the given Machine
 func (r *MachineHealthCheckReconciler) hasMatchingLabels(machineHealthCheck *clusterv1.MachineHealthCheck, machine *clusterv1.Machine) bool {
@@ -0,0 +1,91 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func newTestInformerEntry() (*clusterNodeInformerEntry, <-chan struct{}) {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &clusterNodeInformerEntry{
+		cancel: cancel,
+		mhcs:   map[types.NamespacedName]struct{}{},
+	}, ctx.Done()
+}
+
+func TestReleaseClusterNodeInformer(t *testing.T) {
+	g := NewWithT(t)
+
+	clusterKey := types.NamespacedName{Namespace: "default", Name: "test-cluster"}
+	mhcA := types.NamespacedName{Namespace: "default", Name: "mhc-a"}
+	mhcB := types.NamespacedName{Namespace: "default", Name: "mhc-b"}
+
+	entry, done := newTestInformerEntry()
+	entry.mhcs[mhcA] = struct{}{}
+	entry.mhcs[mhcB] = struct{}{}
+
+	r := &MachineHealthCheckReconciler{
+		clusterNodeInformers: map[types.NamespacedName]*clusterNodeInformerEntry{clusterKey: entry},
+	}
+
+	// Releasing the first of two referencing MHCs must not stop the informer.
+	r.releaseClusterNodeInformer(clusterKey, mhcA)
+	g.Expect(r.clusterNodeInformers).To(HaveKey(clusterKey))
+	select {
+	case <-done:
+		t.Fatal("informer context cancelled while still referenced")
+	default:
+	}
+
+	// Releasing the last referencing MHC must stop the informer and remove the entry.
+	r.releaseClusterNodeInformer(clusterKey, mhcB)
+	g.Expect(r.clusterNodeInformers).ToNot(HaveKey(clusterKey))
+	select {
+	case <-done:
+	default:
+		t.Fatal("expected informer context to be cancelled once unreferenced")
+	}
+}
+
+func TestRemoveClusterNodeInformer(t *testing.T) {
+	g := NewWithT(t)
+
+	clusterKey := types.NamespacedName{Namespace: "default", Name: "test-cluster"}
+	mhc := types.NamespacedName{Namespace: "default", Name: "mhc-a"}
+
+	entry, done := newTestInformerEntry()
+	entry.mhcs[mhc] = struct{}{}
+
+	r := &MachineHealthCheckReconciler{
+		clusterNodeInformers: map[types.NamespacedName]*clusterNodeInformerEntry{clusterKey: entry},
+	}
+
+	// Deleting the Cluster must stop the informer even though an MHC still
+	// references it in the map.
+	r.removeClusterNodeInformer(clusterKey)
+	g.Expect(r.clusterNodeInformers).ToNot(HaveKey(clusterKey))
+	select {
+	case <-done:
+	default:
+		t.Fatal("expected informer context to be cancelled on Cluster deletion")
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+)
+
+func TestGetMaxUnhealthy(t *testing.T) {
+	testCases := []struct {
+		name             string
+		maxUnhealthy     *intstr.IntOrString
+		expectedMachines int32
+		expected         int
+		expectErr        bool
+	}{
+		{
+			name:             "when maxUnhealthy is not set",
+			maxUnhealthy:     nil,
+			expectedMachines: 5,
+			expected:         5,
+		},
+		{
+			name:             "when maxUnhealthy is an absolute number",
+			maxUnhealthy:     intStrPtr(intstr.FromInt(2)),
+			expectedMachines: 5,
+			expected:         2,
+		},
+		{
+			name:             "when maxUnhealthy is a percentage",
+			maxUnhealthy:     intStrPtr(intstr.FromString("40%")),
+			expectedMachines: 5,
+			expected:         2,
+		},
+		{
+			name:             "when maxUnhealthy is a percentage and ExpectedMachines is zero",
+			maxUnhealthy:     intStrPtr(intstr.FromString("40%")),
+			expectedMachines: 0,
+			expected:         0,
+		},
+		{
+			name:             "when maxUnhealthy is an invalid percentage",
+			maxUnhealthy:     intStrPtr(intstr.FromString("not-a-percent")),
+			expectedMachines: 5,
+			expectErr:        true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			m := &clusterv1.MachineHealthCheck{
+				Spec:   clusterv1.MachineHealthCheckSpec{MaxUnhealthy: tc.maxUnhealthy},
+				Status: clusterv1.MachineHealthCheckStatus{ExpectedMachines: tc.expectedMachines},
+			}
+
+			got, err := getMaxUnhealthy(m)
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestIsAllowedRemediation(t *testing.T) {
+	testCases := []struct {
+		name             string
+		maxUnhealthy     *intstr.IntOrString
+		expectedMachines int32
+		currentHealthy   int32
+		expected         bool
+	}{
+		{
+			name:             "when there are no unhealthy targets",
+			maxUnhealthy:     intStrPtr(intstr.FromInt(1)),
+			expectedMachines: 3,
+			currentHealthy:   3,
+			expected:         true,
+		},
+		{
+			name:             "when unhealthy targets are within maxUnhealthy",
+			maxUnhealthy:     intStrPtr(intstr.FromInt(1)),
+			expectedMachines: 3,
+			currentHealthy:   2,
+			expected:         true,
+		},
+		{
+			name:             "when unhealthy targets exceed maxUnhealthy",
+			maxUnhealthy:     intStrPtr(intstr.FromInt(1)),
+			expectedMachines: 3,
+			currentHealthy:   1,
+			expected:         false,
+		},
+		{
+			name:             "when every target is unhealthy",
+			maxUnhealthy:     intStrPtr(intstr.FromString("50%")),
+			expectedMachines: 4,
+			currentHealthy:   0,
+			expected:         false,
+		},
+		{
+			name:             "when there are zero targets",
+			maxUnhealthy:     intStrPtr(intstr.FromInt(0)),
+			expectedMachines: 0,
+			currentHealthy:   0,
+			expected:         true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			m := &clusterv1.MachineHealthCheck{
+				Spec: clusterv1.MachineHealthCheckSpec{MaxUnhealthy: tc.maxUnhealthy},
+				Status: clusterv1.MachineHealthCheckStatus{
+					ExpectedMachines: tc.expectedMachines,
+					CurrentHealthy:   tc.currentHealthy,
+				},
+			}
+
+			g.Expect(isAllowedRemediation(m)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func intStrPtr(v intstr.IntOrString) *intstr.IntOrString {
+	return &v
+}
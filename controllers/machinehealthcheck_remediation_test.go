@@ -0,0 +1,93 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func remediationTestScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	_ = clusterv1.AddToScheme(scheme)
+	return scheme
+}
+
+func TestMachineHealthCheckReconciler_remediate(t *testing.T) {
+	mhc := &clusterv1.MachineHealthCheck{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test-mhc"},
+	}
+
+	t.Run("deletes a Machine that failed its health check", func(t *testing.T) {
+		g := NewWithT(t)
+
+		machine := &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test-machine"},
+		}
+
+		r := &MachineHealthCheckReconciler{
+			Client:   fake.NewFakeClientWithScheme(remediationTestScheme(), machine),
+			Log:      log.NullLogger{},
+			recorder: record.NewFakeRecorder(32),
+		}
+
+		_, err := r.remediate(context.Background(), r.Log, target{Machine: machine, MHC: mhc})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		got := &clusterv1.Machine{}
+		err = r.Client.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "test-machine"}, got)
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	t.Run("skips remediation for a Machine already being deleted", func(t *testing.T) {
+		g := NewWithT(t)
+
+		now := metav1.NewTime(time.Now())
+		machine := &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "default",
+				Name:              "test-machine-deleting",
+				DeletionTimestamp: &now,
+				Finalizers:        []string{"test.cluster.x-k8s.io/block-deletion"},
+			},
+		}
+
+		r := &MachineHealthCheckReconciler{
+			Client:   fake.NewFakeClientWithScheme(remediationTestScheme(), machine),
+			Log:      log.NullLogger{},
+			recorder: record.NewFakeRecorder(32),
+		}
+
+		_, err := r.remediate(context.Background(), r.Log, target{Machine: machine, MHC: mhc})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		got := &clusterv1.Machine{}
+		g.Expect(r.Client.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "test-machine-deleting"}, got)).To(Succeed())
+		g.Expect(got.Annotations).To(BeEmpty())
+	})
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/controllers/remote"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// These periodic safety sweeps exist alongside the watch-driven reconciles
+// to catch the blind spots a per-object Reconcile can't: Nodes that outlive
+// their Machine, and MachineHealthChecks whose selector now matches more
+// Machines than expected. They are modelled on the machine-safety-* periodic
+// checks in machine-controller-manager.
+var orphanedNodesTotal = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "capi_mhc_orphaned_nodes",
+	Help: "Number of Nodes on a target cluster with no corresponding Machine, by cluster",
+}, []string{"cluster", "namespace"})
+
+func init() {
+	metrics.Registry.MustRegister(orphanedNodesTotal)
+}
+
+// setupSafetySweeps registers the orphan-node and overshooting sweeps as
+// manager Runnables so they run on a fixed period independently of any
+// watch, and only on the elected leader. A zero period disables the
+// corresponding sweep.
+func (r *MachineHealthCheckReconciler) setupSafetySweeps(mgr ctrl.Manager, options MachineHealthCheckReconcilerOptions) error {
+	if options.SafetyOrphanNodePeriod > 0 {
+		if err := mgr.Add(manager.RunnableFunc(func(stop <-chan struct{}) error {
+			wait.Until(func() { r.sweepOrphanNodes(context.Background()) }, options.SafetyOrphanNodePeriod, stop)
+			return nil
+		})); err != nil {
+			return err
+		}
+	}
+
+	if options.SafetyOvershootingPeriod > 0 {
+		if err := mgr.Add(manager.RunnableFunc(func(stop <-chan struct{}) error {
+			wait.Until(func() { r.sweepOvershooting(context.Background()) }, options.SafetyOvershootingPeriod, stop)
+			return nil
+		})); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sweepOrphanNodes lists the Nodes on every known target cluster and reports
+// any whose providerID has no corresponding Machine in the management
+// cluster.
+func (r *MachineHealthCheckReconciler) sweepOrphanNodes(ctx context.Context) {
+	logger := r.Log.WithValues("sweep", "orphan-node")
+
+	clusterList := &clusterv1.ClusterList{}
+	if err := r.Client.List(ctx, clusterList); err != nil {
+		logger.Error(err, "Failed to list Clusters for orphan-node sweep")
+		return
+	}
+
+	for i := range clusterList.Items {
+		r.sweepOrphanNodesForCluster(ctx, logger, &clusterList.Items[i])
+	}
+}
+
+func (r *MachineHealthCheckReconciler) sweepOrphanNodesForCluster(ctx context.Context, logger logr.Logger, cluster *clusterv1.Cluster) {
+	clusterClient, err := remote.NewClusterClient(r.Client, cluster, r.scheme)
+	if err != nil {
+		logger.Error(err, "Failed to build target cluster client for orphan-node sweep", "cluster", cluster.Name)
+		return
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := clusterClient.List(ctx, nodeList); err != nil {
+		logger.Error(err, "Failed to list Nodes for orphan-node sweep", "cluster", cluster.Name)
+		return
+	}
+
+	// mhcClusterNameIndex is only registered against MachineHealthCheck (see
+	// SetupWithManager); Machines carry no such field index, but they are
+	// labelled with their owning Cluster's name, so list by that instead.
+	machineList := &clusterv1.MachineList{}
+	if err := r.Client.List(ctx, machineList, client.InNamespace(cluster.Namespace), client.MatchingLabels{clusterv1.ClusterLabelName: cluster.Name}); err != nil {
+		logger.Error(err, "Failed to list Machines for orphan-node sweep", "cluster", cluster.Name)
+		return
+	}
+
+	providerIDs := make(map[string]struct{}, len(machineList.Items))
+	for _, m := range machineList.Items {
+		if m.Spec.ProviderID != nil {
+			providerIDs[*m.Spec.ProviderID] = struct{}{}
+		}
+	}
+
+	orphaned := 0
+	for _, node := range nodeList.Items {
+		if node.Spec.ProviderID == "" {
+			continue
+		}
+		if _, ok := providerIDs[node.Spec.ProviderID]; !ok {
+			orphaned++
+			nodeCopy := node
+			r.recorder.Eventf(cluster, corev1.EventTypeWarning, "NodeOrphaned", "Node %s has providerID %q with no corresponding Machine", nodeCopy.Name, nodeCopy.Spec.ProviderID)
+		}
+	}
+
+	orphanedNodesTotal.WithLabelValues(cluster.Name, cluster.Namespace).Set(float64(orphaned))
+}
+
+// sweepOvershooting counts the Machines matching each MachineHealthCheck's
+// selector and, if the total exceeds ExpectedMachines+MaxUnhealthy, records
+// an event so operators notice the selector is now too broad. It does not
+// block remediation and does not touch the reconciler's own
+// RemediationAllowed condition (set per-pass by reconcile itself).
+func (r *MachineHealthCheckReconciler) sweepOvershooting(ctx context.Context) {
+	logger := r.Log.WithValues("sweep", "overshooting")
+
+	mhcList := &clusterv1.MachineHealthCheckList{}
+	if err := r.Client.List(ctx, mhcList); err != nil {
+		logger.Error(err, "Failed to list MachineHealthChecks for overshooting sweep")
+		return
+	}
+
+	for i := range mhcList.Items {
+		m := &mhcList.Items[i]
+		r.sweepOvershootingForMHC(ctx, logger, m)
+	}
+}
+
+func (r *MachineHealthCheckReconciler) sweepOvershootingForMHC(ctx context.Context, logger logr.Logger, m *clusterv1.MachineHealthCheck) {
+	selector, err := metav1.LabelSelectorAsSelector(&m.Spec.Selector)
+	if err != nil {
+		logger.Error(err, "Failed to parse selector for overshooting sweep", "machinehealthcheck", m.Name, "namespace", m.Namespace)
+		return
+	}
+
+	machineList := &clusterv1.MachineList{}
+	if err := r.Client.List(ctx, machineList, client.InNamespace(m.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		logger.Error(err, "Failed to list Machines for overshooting sweep", "machinehealthcheck", m.Name, "namespace", m.Namespace)
+		return
+	}
+
+	// getMaxUnhealthy treats a nil MaxUnhealthy as "no limit" by returning
+	// ExpectedMachines, which is the right answer for isAllowedRemediation but
+	// would double ExpectedMachines in this threshold. An unset MaxUnhealthy
+	// means the sweep should flag anything beyond ExpectedMachines.
+	maxUnhealthy := 0
+	if m.Spec.MaxUnhealthy != nil {
+		mu, err := getMaxUnhealthy(m)
+		if err != nil {
+			logger.Error(err, "Failed to compute maxUnhealthy for overshooting sweep", "machinehealthcheck", m.Name, "namespace", m.Namespace)
+			return
+		}
+		maxUnhealthy = mu
+	}
+
+	if len(machineList.Items) > int(m.Status.ExpectedMachines)+maxUnhealthy {
+		logger.V(2).Info("MachineHealthCheck selector matches more Machines than expected",
+			"machinehealthcheck", m.Name, "namespace", m.Namespace, "matched", len(machineList.Items), "expected", m.Status.ExpectedMachines)
+		r.recorder.Eventf(m, corev1.EventTypeWarning, "Overshooting",
+			"Selector matches %d Machines, more than ExpectedMachines(%d)+MaxUnhealthy(%d)", len(machineList.Items), m.Status.ExpectedMachines, maxUnhealthy)
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func machinesWithRole(n int, role string) []runtime.Object {
+	objs := make([]runtime.Object, 0, n)
+	for i := 0; i < n; i++ {
+		objs = append(objs, &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      fmt.Sprintf("test-machine-%d", i),
+				Labels:    map[string]string{"role": role},
+			},
+		})
+	}
+	return objs
+}
+
+func TestSweepOvershootingForMHC(t *testing.T) {
+	mhc := func(expected int32) *clusterv1.MachineHealthCheck {
+		return &clusterv1.MachineHealthCheck{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test-mhc"},
+			Spec: clusterv1.MachineHealthCheckSpec{
+				Selector: metav1.LabelSelector{MatchLabels: map[string]string{"role": "worker"}},
+			},
+			Status: clusterv1.MachineHealthCheckStatus{ExpectedMachines: expected},
+		}
+	}
+
+	t.Run("records an event when the selector matches more Machines than expected", func(t *testing.T) {
+		g := NewWithT(t)
+
+		recorder := record.NewFakeRecorder(32)
+		r := &MachineHealthCheckReconciler{
+			Client:   fake.NewFakeClientWithScheme(remediationTestScheme(), machinesWithRole(4, "worker")...),
+			Log:      log.NullLogger{},
+			recorder: recorder,
+		}
+
+		r.sweepOvershootingForMHC(context.Background(), r.Log, mhc(2))
+
+		g.Eventually(recorder.Events).Should(Receive(ContainSubstring("Overshooting")))
+	})
+
+	t.Run("does not record an event when the selector matches no more than expected", func(t *testing.T) {
+		g := NewWithT(t)
+
+		recorder := record.NewFakeRecorder(32)
+		r := &MachineHealthCheckReconciler{
+			Client:   fake.NewFakeClientWithScheme(remediationTestScheme(), machinesWithRole(2, "worker")...),
+			Log:      log.NullLogger{},
+			recorder: recorder,
+		}
+
+		r.sweepOvershootingForMHC(context.Background(), r.Log, mhc(2))
+
+		g.Consistently(recorder.Events).ShouldNot(Receive())
+	})
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remediation
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	pb "sigs.k8s.io/cluster-api/controllers/mhc/remediation/proto"
+)
+
+const (
+	// dialTimeout bounds how long Dial will wait for the connection to come
+	// up. The ctx reconcile passes down has no deadline of its own
+	// (context.Background()), so without this an unreachable provider would
+	// block the reconcile worker, and every cached strategy's retry,
+	// indefinitely.
+	dialTimeout = 10 * time.Second
+	// remediateTimeout bounds a single Remediate RPC, for the same reason.
+	remediateTimeout = 30 * time.Second
+)
+
+// GRPCRemediation delegates remediation of unhealthy targets to an
+// out-of-tree provider over gRPC, following the extension pattern used by
+// machine-controller-manager. It gives operators an integration point for
+// BMC power-cycling, cloud-provider-specific rebuilds, or ticketing systems
+// without forking the controller.
+type GRPCRemediation struct {
+	// Endpoint is the host:port of the remediation provider.
+	Endpoint string
+	// TLSConfig, when set, is used to dial Endpoint over TLS. A nil value
+	// dials insecurely, which should only be used for local testing.
+	TLSConfig credentials.TransportCredentials
+
+	conn   *grpc.ClientConn
+	client pb.RemediationClient
+}
+
+// Dial establishes the connection to the remediation provider. Remediate
+// calls it on first use if no connection has been established yet. The dial
+// is bounded by dialTimeout so an unreachable provider fails fast instead of
+// wedging the reconcile worker.
+func (g *GRPCRemediation) Dial(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+
+	opts := []grpc.DialOption{grpc.WithBlock()}
+	if g.TLSConfig != nil {
+		opts = append(opts, grpc.WithTransportCredentials(g.TLSConfig))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+
+	conn, err := grpc.DialContext(ctx, g.Endpoint, opts...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to dial remediation provider at %q", g.Endpoint)
+	}
+
+	g.conn = conn
+	g.client = pb.NewRemediationClient(conn)
+	return nil
+}
+
+// Close tears down the connection to the remediation provider.
+func (g *GRPCRemediation) Close() error {
+	if g.conn == nil {
+		return nil
+	}
+	return g.conn.Close()
+}
+
+// Remediate calls the configured remediation provider and honors the
+// requeueAfter it returns.
+func (g *GRPCRemediation) Remediate(ctx context.Context, target Target) (time.Duration, error) {
+	if g.client == nil {
+		if err := g.Dial(ctx); err != nil {
+			return 0, err
+		}
+	}
+
+	nodeName := ""
+	if target.Node != nil {
+		nodeName = target.Node.Name
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, remediateTimeout)
+	defer cancel()
+
+	ack, err := g.client.Remediate(ctx, &pb.RemediateRequest{
+		ClusterName: target.Cluster.Name,
+		MachineName: target.Machine.Name,
+		Namespace:   target.Machine.Namespace,
+		NodeName:    nodeName,
+		Reason:      target.Reason,
+	})
+	if err != nil {
+		return 0, errors.Wrapf(err, "remediation provider at %q rejected Machine %s/%s", g.Endpoint, target.Machine.Namespace, target.Machine.Name)
+	}
+
+	return time.Duration(ack.RequeueAfterSeconds) * time.Second, nil
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package remediation provides pluggable strategies that the
+// MachineHealthCheckReconciler can delegate to when a target is deemed
+// unhealthy, instead of always deleting the Machine itself.
+package remediation
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Target is the subset of information about an unhealthy MachineHealthCheck
+// target that a RemediationStrategy needs in order to act on it.
+type Target struct {
+	Cluster *clusterv1.Cluster
+	MHC     *clusterv1.MachineHealthCheck
+	Machine *clusterv1.Machine
+	Node    *corev1.Node
+	Reason  string
+}
+
+// RemediationStrategy performs remediation of an unhealthy target on behalf
+// of the MachineHealthCheckReconciler. Implementations decide how (or
+// whether) to act; the reconciler only needs to know when to check back in.
+type RemediationStrategy interface {
+	// Remediate acts on the unhealthy target and returns how long the
+	// reconciler should wait before re-checking it. A zero duration means
+	// the reconciler's default requeue behaviour applies.
+	Remediate(ctx context.Context, target Target) (requeueAfter time.Duration, err error)
+}
+
+// MachineDeletion is the RemediationStrategy used by default: it deletes the
+// Machine behind the target, triggering the standard Machine controller
+// teardown of its infrastructure and bootstrap references.
+type MachineDeletion struct {
+	Client client.Client
+}
+
+// Remediate deletes the Machine behind the target.
+func (m MachineDeletion) Remediate(ctx context.Context, target Target) (time.Duration, error) {
+	if !target.Machine.DeletionTimestamp.IsZero() {
+		return 0, nil
+	}
+	return 0, m.Client.Delete(ctx, target.Machine)
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remediation
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func testScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	_ = clusterv1.AddToScheme(scheme)
+	return scheme
+}
+
+func TestMachineDeletion_Remediate(t *testing.T) {
+	g := NewWithT(t)
+
+	machine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test-machine"},
+	}
+	c := fake.NewFakeClientWithScheme(testScheme(), machine)
+	strategy := MachineDeletion{Client: c}
+
+	requeueAfter, err := strategy.Remediate(context.Background(), Target{Machine: machine})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(requeueAfter).To(BeZero())
+
+	got := &clusterv1.Machine{}
+	err = c.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "test-machine"}, got)
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+}
+
+func TestMachineDeletion_Remediate_AlreadyDeleting(t *testing.T) {
+	g := NewWithT(t)
+
+	now := metav1.Now()
+	machine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "default",
+			Name:              "test-machine",
+			DeletionTimestamp: &now,
+			Finalizers:        []string{"test.cluster.x-k8s.io/block-deletion"},
+		},
+	}
+	c := fake.NewFakeClientWithScheme(testScheme(), machine)
+	strategy := MachineDeletion{Client: c}
+
+	requeueAfter, err := strategy.Remediate(context.Background(), Target{Machine: machine})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(requeueAfter).To(BeZero())
+
+	got := &clusterv1.Machine{}
+	g.Expect(c.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "test-machine"}, got)).To(Succeed())
+}